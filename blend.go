@@ -0,0 +1,36 @@
+package rt
+
+// MultiplyColor returns the Hadamard (component-wise) product of c and
+// other, the standard "multiply" blend mode: darker everywhere the colors
+// overlap.
+func (c Color) MultiplyColor(other Color) Color {
+	return NewColor(c.R()*other.R(), c.G()*other.G(), c.B()*other.B())
+}
+
+// Screen returns the "screen" blend of c and other, the inverse of
+// Multiply: lighter everywhere the colors overlap.
+func (c Color) Screen(other Color) Color {
+	return NewColor(
+		1-(1-c.R())*(1-other.R()),
+		1-(1-c.G())*(1-other.G()),
+		1-(1-c.B())*(1-other.B()),
+	)
+}
+
+// Overlay returns the "overlay" blend of c and other: Multiply where c is
+// dark, Screen where c is light.
+func (c Color) Overlay(other Color) Color {
+	return NewColor(
+		overlayChannel(c.R(), other.R()),
+		overlayChannel(c.G(), other.G()),
+		overlayChannel(c.B(), other.B()),
+	)
+}
+
+func overlayChannel(base, blend float64) float64 {
+	if base < 0.5 {
+		return 2 * base * blend
+	}
+
+	return 1 - 2*(1-base)*(1-blend)
+}
@@ -0,0 +1,105 @@
+package rt
+
+import "math"
+
+// A BoundingBox is an axis-aligned box enclosing a shape or group of
+// shapes, used to quickly reject rays that can't possibly hit anything
+// inside it.
+type BoundingBox struct {
+	Min Tuple
+	Max Tuple
+}
+
+// NewBoundingBox creates a new BoundingBox spanning min to max.
+func NewBoundingBox(min, max Tuple) BoundingBox {
+	return BoundingBox{Min: min, Max: max}
+}
+
+// EmptyBoundingBox returns a degenerate BoundingBox that contains nothing,
+// suitable as the starting point for Add.
+func EmptyBoundingBox() BoundingBox {
+	return BoundingBox{
+		Min: NewPoint(math.Inf(1), math.Inf(1), math.Inf(1)),
+		Max: NewPoint(math.Inf(-1), math.Inf(-1), math.Inf(-1)),
+	}
+}
+
+// Add grows the box, if necessary, to also contain point.
+func (b *BoundingBox) Add(point Tuple) {
+	b.Min = NewPoint(math.Min(b.Min.X(), point.X()), math.Min(b.Min.Y(), point.Y()), math.Min(b.Min.Z(), point.Z()))
+	b.Max = NewPoint(math.Max(b.Max.X(), point.X()), math.Max(b.Max.Y(), point.Y()), math.Max(b.Max.Z(), point.Z()))
+}
+
+// AddBox grows the box, if necessary, to also contain other.
+func (b *BoundingBox) AddBox(other BoundingBox) {
+	b.Add(other.Min)
+	b.Add(other.Max)
+}
+
+// Corners returns the eight corners of the box.
+func (b BoundingBox) Corners() [8]Tuple {
+	return [8]Tuple{
+		NewPoint(b.Min.X(), b.Min.Y(), b.Min.Z()),
+		NewPoint(b.Min.X(), b.Min.Y(), b.Max.Z()),
+		NewPoint(b.Min.X(), b.Max.Y(), b.Min.Z()),
+		NewPoint(b.Min.X(), b.Max.Y(), b.Max.Z()),
+		NewPoint(b.Max.X(), b.Min.Y(), b.Min.Z()),
+		NewPoint(b.Max.X(), b.Min.Y(), b.Max.Z()),
+		NewPoint(b.Max.X(), b.Max.Y(), b.Min.Z()),
+		NewPoint(b.Max.X(), b.Max.Y(), b.Max.Z()),
+	}
+}
+
+// Transform returns the box that results from applying transform to every
+// corner of b and taking the min/max of the results.
+func (b BoundingBox) Transform(transform Transformation) BoundingBox {
+	result := EmptyBoundingBox()
+	for _, corner := range b.Corners() {
+		result.Add(transform.ApplyTo(corner))
+	}
+
+	return result
+}
+
+// Intersects reports whether ray intersects the box, using the slab
+// method: for each axis, compute the interval of t for which the ray is
+// within the box's slab, and check whether the intersection of all three
+// intervals is non-empty.
+func (b BoundingBox) Intersects(ray *Ray) bool {
+	tMinX, tMaxX := checkAxis(ray.Origin.X(), ray.Direction.X(), b.Min.X(), b.Max.X())
+	tMinY, tMaxY := checkAxis(ray.Origin.Y(), ray.Direction.Y(), b.Min.Y(), b.Max.Y())
+	tMinZ, tMaxZ := checkAxis(ray.Origin.Z(), ray.Direction.Z(), b.Min.Z(), b.Max.Z())
+
+	tMin := math.Max(tMinX, math.Max(tMinY, tMinZ))
+	tMax := math.Min(tMaxX, math.Min(tMaxY, tMaxZ))
+
+	return tMin <= tMax
+}
+
+// checkAxis returns the interval of t along one axis for which a ray with
+// the given origin and direction component stays within [min, max].
+func checkAxis(origin, direction, min, max float64) (tMin, tMax float64) {
+	tMinNumerator := min - origin
+	tMaxNumerator := max - origin
+
+	var tMinCandidate, tMaxCandidate float64
+	if math.Abs(direction) >= epsilon {
+		tMinCandidate = tMinNumerator / direction
+		tMaxCandidate = tMaxNumerator / direction
+	} else {
+		tMinCandidate = tMinNumerator * math.Inf(1)
+		tMaxCandidate = tMaxNumerator * math.Inf(1)
+	}
+
+	if tMinCandidate > tMaxCandidate {
+		tMinCandidate, tMaxCandidate = tMaxCandidate, tMinCandidate
+	}
+
+	return tMinCandidate, tMaxCandidate
+}
+
+// ParentSpaceBounds returns shape's LocalBounds transformed into the space
+// of its parent, by transforming all 8 corners and taking the min/max.
+func ParentSpaceBounds(shape Shape) BoundingBox {
+	return shape.LocalBounds().Transform(shape.GetTransform())
+}
@@ -0,0 +1,41 @@
+package rt
+
+import "testing"
+
+func TestBoundingBoxIntersects(t *testing.T) {
+	box := NewBoundingBox(NewPoint(-1, -1, -1), NewPoint(1, 1, 1))
+
+	tests := []struct {
+		name      string
+		origin    Tuple
+		direction Tuple
+		want      bool
+	}{
+		{"+x", NewPoint(5, 0.5, 0), NewVector(-1, 0, 0), true},
+		{"-x", NewPoint(-5, 0.5, 0), NewVector(1, 0, 0), true},
+		{"+y", NewPoint(0.5, 5, 0), NewVector(0, -1, 0), true},
+		{"+z", NewPoint(0.5, 0, 5), NewVector(0, 0, -1), true},
+		{"inside", NewPoint(0, 0.5, 0), NewVector(0, 0, 1), true},
+		{"parallel miss", NewPoint(-2, 0, 0), NewVector(0, 1, 0), false},
+		{"diagonal miss", NewPoint(-2, 2, -2), NewVector(0.2673, 0.5345, 0.8018), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ray := NewRay(tt.origin, tt.direction.Normalize())
+			if got := box.Intersects(ray); got != tt.want {
+				t.Errorf("Intersects() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoundingBoxTransform(t *testing.T) {
+	box := NewBoundingBox(NewPoint(-1, -1, -1), NewPoint(1, 1, 1))
+	transformed := box.Transform(NewTransform().Translate(5, 0, 0))
+
+	want := NewPoint(4, -1, -1)
+	if transformed.Min.X() != want.X() {
+		t.Errorf("transformed.Min.X() = %v, want %v", transformed.Min.X(), want.X())
+	}
+}
@@ -0,0 +1,59 @@
+package rt
+
+import "math"
+
+// A Camera describes the view of the world that will be rendered to a
+// canvas: its resolution, field of view, and where it's positioned and
+// aimed via its transform.
+type Camera struct {
+	HSize       int
+	VSize       int
+	FieldOfView float64
+	Transform   Transformation
+
+	halfWidth  float64
+	halfHeight float64
+	pixelSize  float64
+}
+
+// NewCamera creates a new Camera with the given canvas resolution and
+// field of view (in radians), aimed down -z by default.
+func NewCamera(hsize, vsize int, fieldOfView float64) *Camera {
+	c := &Camera{
+		HSize:       hsize,
+		VSize:       vsize,
+		FieldOfView: fieldOfView,
+		Transform:   NewTransform(),
+	}
+
+	halfView := math.Tan(fieldOfView / 2)
+	aspect := float64(hsize) / float64(vsize)
+
+	if aspect >= 1 {
+		c.halfWidth = halfView
+		c.halfHeight = halfView / aspect
+	} else {
+		c.halfWidth = halfView * aspect
+		c.halfHeight = halfView
+	}
+
+	c.pixelSize = (c.halfWidth * 2) / float64(hsize)
+	return c
+}
+
+// RayForPixel returns the ray that starts at the camera and passes through
+// the center of the pixel at (x, y) on the canvas.
+func (c *Camera) RayForPixel(x, y int) *Ray {
+	xOffset := (float64(x) + 0.5) * c.pixelSize
+	yOffset := (float64(y) + 0.5) * c.pixelSize
+
+	worldX := c.halfWidth - xOffset
+	worldY := c.halfHeight - yOffset
+
+	inverse := c.Transform.Inverse()
+	pixel := inverse.ApplyTo(NewPoint(worldX, worldY, -1))
+	origin := inverse.ApplyTo(NewPoint(0, 0, 0))
+	direction := pixel.Subtract(origin).Normalize()
+
+	return NewRay(origin, direction)
+}
@@ -0,0 +1,91 @@
+package rt
+
+// Computations holds the precomputed state of a ray-object intersection
+// needed to shade it: the point of intersection, the vectors needed for
+// lighting, and (for refraction) the refractive indices of the materials
+// on either side of the surface.
+type Computations struct {
+	T          float64
+	Object     Shape
+	Point      Tuple
+	OverPoint  Tuple
+	UnderPoint Tuple
+	Eyev       Tuple
+	Normalv    Tuple
+	Reflectv   Tuple
+	Inside     bool
+	N1         float64
+	N2         float64
+}
+
+// PrepareComputations precomputes the shading state for hit, given the ray
+// that produced it and the full set of intersections the ray produced
+// (needed to resolve N1/N2 for overlapping transparent objects).
+func PrepareComputations(hit *Intersection, ray *Ray, allIntersections IntersectionSet) Computations {
+	comps := Computations{
+		T:      hit.T,
+		Object: hit.Object,
+		Point:  ray.Position(hit.T),
+		Eyev:   ray.Direction.Negate(),
+	}
+
+	comps.Normalv = comps.Object.NormalAt(comps.Point)
+	if comps.Normalv.Dot(comps.Eyev) < 0 {
+		comps.Inside = true
+		comps.Normalv = comps.Normalv.Negate()
+	}
+
+	comps.Reflectv = ray.Direction.Reflect(comps.Normalv)
+	comps.OverPoint = comps.Point.Add(comps.Normalv.Multiply(epsilon))
+	comps.UnderPoint = comps.Point.Subtract(comps.Normalv.Multiply(epsilon))
+
+	comps.N1, comps.N2 = refractiveIndices(hit, allIntersections)
+	return comps
+}
+
+// refractiveIndices walks allIntersections up to hit, maintaining a stack
+// of the transparent objects the ray currently is "inside", to determine
+// the refractive index on either side of the surface at hit.
+func refractiveIndices(hit *Intersection, allIntersections IntersectionSet) (n1, n2 float64) {
+	var containers []Shape
+
+	contains := func(shape Shape) int {
+		for i, s := range containers {
+			if s == shape {
+				return i
+			}
+		}
+
+		return -1
+	}
+
+	for _, i := range allIntersections {
+		isHit := i == *hit
+
+		if isHit {
+			if len(containers) == 0 {
+				n1 = 1
+			} else {
+				n1 = containers[len(containers)-1].GetMaterial().RefractiveIndex
+			}
+		}
+
+		if idx := contains(i.Object); idx >= 0 {
+			containers = append(containers[:idx], containers[idx+1:]...)
+		} else {
+			containers = append(containers, i.Object)
+		}
+
+		if isHit {
+			if len(containers) == 0 {
+				n2 = 1
+			} else {
+				n2 = containers[len(containers)-1].GetMaterial().RefractiveIndex
+			}
+
+			break
+		}
+	}
+
+	return n1, n2
+}
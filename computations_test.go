@@ -0,0 +1,74 @@
+package rt
+
+import (
+	"math"
+	"testing"
+)
+
+func glassSphere(ior float64) *Sphere {
+	s := NewSphere()
+	s.Material.Transparency = 1
+	s.Material.RefractiveIndex = ior
+	return s
+}
+
+// TestRefractiveIndices reproduces the classic three-overlapping-spheres
+// scenario: a ray through three concentric glass spheres of increasing
+// refractive index should see n1/n2 step through every boundary in order.
+func TestRefractiveIndices(t *testing.T) {
+	a := glassSphere(1.5)
+	a.SetTransform(NewTransform().Scale(2, 2, 2))
+
+	b := glassSphere(2.0)
+	b.SetTransform(NewTransform().Translate(0, 0, -0.25))
+
+	c := glassSphere(2.5)
+	c.SetTransform(NewTransform().Translate(0, 0, 0.25))
+
+	xs := IntersectionSet{
+		NewIntersection(2, a),
+		NewIntersection(2.75, b),
+		NewIntersection(3.25, c),
+		NewIntersection(4.75, b),
+		NewIntersection(5.25, c),
+		NewIntersection(6, a),
+	}
+
+	wantN1 := []float64{1.0, 1.5, 2.0, 2.5, 2.5, 1.5}
+	wantN2 := []float64{1.5, 2.0, 2.5, 2.5, 1.5, 1.0}
+
+	for i := range xs {
+		n1, n2 := refractiveIndices(&xs[i], xs)
+		if n1 != wantN1[i] || n2 != wantN2[i] {
+			t.Errorf("index %d: refractiveIndices() = (%v, %v), want (%v, %v)", i, n1, n2, wantN1[i], wantN2[i])
+		}
+	}
+}
+
+func TestSchlickTotalInternalReflection(t *testing.T) {
+	shape := glassSphere(1.5)
+	ray := NewRay(NewPoint(0, 0, math.Sqrt2/2), NewVector(0, 1, 0))
+	xs := IntersectionSet{
+		NewIntersection(-math.Sqrt2/2, shape),
+		NewIntersection(math.Sqrt2/2, shape),
+	}
+
+	comps := PrepareComputations(&xs[1], ray, xs)
+	if reflectance := Schlick(comps); math.Abs(reflectance-1) > epsilon {
+		t.Errorf("Schlick() = %v, want 1 (total internal reflection)", reflectance)
+	}
+}
+
+func TestSchlickPerpendicularViewingAngle(t *testing.T) {
+	shape := glassSphere(1.5)
+	ray := NewRay(NewPoint(0, 0, 0), NewVector(0, 1, 0))
+	xs := IntersectionSet{
+		NewIntersection(-1, shape),
+		NewIntersection(1, shape),
+	}
+
+	comps := PrepareComputations(&xs[1], ray, xs)
+	if reflectance := Schlick(comps); math.Abs(reflectance-0.04) > 1e-4 {
+		t.Errorf("Schlick() = %v, want approximately 0.04", reflectance)
+	}
+}
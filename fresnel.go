@@ -0,0 +1,24 @@
+package rt
+
+import "math"
+
+// Schlick returns the Fresnel reflectance for comps, the fraction of light
+// reflected given the surface geometry, using Christophe Schlick's
+// approximation to the Fresnel equations.
+func Schlick(comps Computations) float64 {
+	cos := comps.Eyev.Dot(comps.Normalv)
+
+	if comps.N1 > comps.N2 {
+		n := comps.N1 / comps.N2
+		sin2t := n * n * (1 - cos*cos)
+		if sin2t > 1 {
+			return 1
+		}
+
+		cosT := math.Sqrt(1 - sin2t)
+		cos = cosT
+	}
+
+	r0 := math.Pow((comps.N1-comps.N2)/(comps.N1+comps.N2), 2)
+	return r0 + (1-r0)*math.Pow(1-cos, 5)
+}
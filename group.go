@@ -0,0 +1,153 @@
+package rt
+
+// A Group is a Shape that owns a collection of child shapes and positions
+// them relative to its own transform. Intersect short-circuits on the
+// group's bounding box before testing any children, which is what makes a
+// BVH of nested groups fast to render.
+type Group struct {
+	Transform Transformation
+	Material  *Material
+	Children  []Shape
+
+	// bounds caches the union of every child's ParentSpaceBounds, kept up
+	// to date incrementally by Add so LocalBounds (and therefore
+	// Intersect) never has to walk the subtree.
+	bounds BoundingBox
+}
+
+// NewGroup creates a new, empty Group.
+func NewGroup() *Group {
+	return &Group{
+		Transform: NewTransform(),
+		Material:  NewMaterial(),
+		bounds:    EmptyBoundingBox(),
+	}
+}
+
+// Add appends a child shape to the group and folds its bounds into the
+// group's cached bounding box.
+func (g *Group) Add(child Shape) {
+	g.Children = append(g.Children, child)
+	g.bounds.AddBox(ParentSpaceBounds(child))
+}
+
+// GetTransform returns the group's transformation.
+func (g *Group) GetTransform() Transformation {
+	return g.Transform
+}
+
+// SetTransform sets the group's transformation.
+func (g *Group) SetTransform(transform Transformation) {
+	g.Transform = transform
+}
+
+// GetMaterial returns the group's material. Groups don't render directly,
+// but they satisfy Shape so they can nest inside other groups.
+func (g *Group) GetMaterial() *Material {
+	return g.Material
+}
+
+// SetMaterial sets the group's material.
+func (g *Group) SetMaterial(material *Material) {
+	g.Material = material
+}
+
+// LocalBounds returns a box tightly enclosing every child's bounds, in the
+// group's own object space. The box is cached and updated incrementally by
+// Add, so this is O(1) rather than a walk of the subtree.
+func (g *Group) LocalBounds() BoundingBox {
+	return g.bounds
+}
+
+// Intersect returns every intersection of the ray with the group's
+// children, or an empty set immediately if the ray misses the group's
+// bounding box.
+func (g *Group) Intersect(ray *Ray) IntersectionSet {
+	localRay := ray.Transform(g.Transform.Inverse())
+	if !g.LocalBounds().Intersects(localRay) {
+		return IntersectionSet{}
+	}
+
+	var all IntersectionSet
+	for _, child := range g.Children {
+		all = append(all, child.Intersect(localRay)...)
+	}
+
+	all.Sort()
+	return all
+}
+
+// NormalAt is not meaningful for a Group; it exists only so Group
+// satisfies Shape.
+func (g *Group) NormalAt(point Tuple) Tuple {
+	return NewVector(0, 0, 0)
+}
+
+// Divide recursively splits the group's children into two sub-groups along
+// the longest axis of its bounds whenever it has more than threshold
+// children, which keeps any one group's Intersect check cheap.
+func (g *Group) Divide(threshold int) {
+	if len(g.Children) <= threshold {
+		return
+	}
+
+	left, right := g.partitionChildren()
+	if len(left) == 0 || len(right) == 0 {
+		// The split didn't separate anything — e.g. one oversized child
+		// dominates the bounds — so dividing further would just recreate
+		// an identical group and recurse forever. Leave the children as
+		// they are.
+		return
+	}
+
+	leftGroup := NewGroup()
+	for _, child := range left {
+		leftGroup.Add(child)
+	}
+	leftGroup.Divide(threshold)
+
+	rightGroup := NewGroup()
+	for _, child := range right {
+		rightGroup.Add(child)
+	}
+	rightGroup.Divide(threshold)
+
+	// partitionChildren assigns every child to left or right, so these two
+	// groups are the whole of g's former children, just regrouped; g's own
+	// bounds are unchanged.
+	g.Children = []Shape{leftGroup, rightGroup}
+}
+
+// partitionChildren splits the group's children into two halves by
+// whichever side of the midpoint, along the bounds' longest axis, each
+// child's bounds center falls on.
+func (g *Group) partitionChildren() (left, right []Shape) {
+	bounds := g.LocalBounds()
+	dx := bounds.Max.X() - bounds.Min.X()
+	dy := bounds.Max.Y() - bounds.Min.Y()
+	dz := bounds.Max.Z() - bounds.Min.Z()
+
+	for _, child := range g.Children {
+		childBounds := ParentSpaceBounds(child)
+		var center, mid float64
+		switch {
+		case dx >= dy && dx >= dz:
+			center = (childBounds.Min.X() + childBounds.Max.X()) / 2
+			mid = (bounds.Min.X() + bounds.Max.X()) / 2
+		case dy >= dz:
+			center = (childBounds.Min.Y() + childBounds.Max.Y()) / 2
+			mid = (bounds.Min.Y() + bounds.Max.Y()) / 2
+		default:
+			center = (childBounds.Min.Z() + childBounds.Max.Z()) / 2
+			mid = (bounds.Min.Z() + bounds.Max.Z()) / 2
+		}
+
+		if center < mid {
+			left = append(left, child)
+		} else {
+			right = append(right, child)
+		}
+	}
+
+	return left, right
+}
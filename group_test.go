@@ -0,0 +1,63 @@
+package rt
+
+import "testing"
+
+func TestGroupDivideSplitsSeparatedChildren(t *testing.T) {
+	left := NewSphere()
+	left.SetTransform(NewTransform().Translate(-2, 0, 0))
+
+	right := NewSphere()
+	right.SetTransform(NewTransform().Translate(2, 0, 0))
+
+	middle := NewSphere()
+
+	g := NewGroup()
+	g.Add(left)
+	g.Add(right)
+	g.Add(middle)
+
+	g.Divide(1)
+
+	if len(g.Children) != 2 {
+		t.Fatalf("len(g.Children) = %d, want 2", len(g.Children))
+	}
+
+	for _, child := range g.Children {
+		if _, ok := child.(*Group); !ok {
+			t.Errorf("child %v is not a *Group", child)
+		}
+	}
+}
+
+func TestGroupDivideDoesNotRecurseForeverOnSkewedBounds(t *testing.T) {
+	// Three children with identical bounds all have a center exactly
+	// equal to the midpoint, so partitionChildren's strict "center < mid"
+	// test puts every one of them on the same side. Divide must bail out
+	// here instead of recreating an identical group and recursing
+	// forever.
+	g := NewGroup()
+	g.Add(NewSphere())
+	g.Add(NewSphere())
+	g.Add(NewSphere())
+
+	// Divide must return promptly rather than recursing forever; if the
+	// bug regresses, this call hangs and the test times out.
+	g.Divide(1)
+
+	if len(g.Children) != 3 {
+		t.Fatalf("len(g.Children) = %d, want 3 (unchanged when the split can't separate anything)", len(g.Children))
+	}
+}
+
+func TestGroupLocalBoundsCachedByAdd(t *testing.T) {
+	g := NewGroup()
+	sphere := NewSphere()
+	sphere.SetTransform(NewTransform().Translate(2, 0, 0))
+	g.Add(sphere)
+
+	bounds := g.LocalBounds()
+	want := NewPoint(1, -1, -1)
+	if bounds.Min.X() != want.X() {
+		t.Errorf("bounds.Min.X() = %v, want %v", bounds.Min.X(), want.X())
+	}
+}
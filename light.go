@@ -0,0 +1,92 @@
+package rt
+
+import "math/rand"
+
+// A Light illuminates objects in a World and can be sampled for shading and
+// shadow calculations. PointLight and AreaLight both implement Light so
+// World can treat hard and soft light sources interchangeably.
+type Light interface {
+	// Intensity returns the color and brightness of the light.
+	Intensity() Color
+	// SamplePoints returns the world-space points on the light to sample
+	// when computing shading and shadows at a pixel. rng drives any
+	// jitter so results are deterministic for a given seed.
+	SamplePoints(rng *rand.Rand) []Tuple
+}
+
+// A PointLight is a light source with no size, existing at a single point
+// in space.
+type PointLight struct {
+	Position  Tuple
+	intensity Color
+}
+
+// NewPointLight creates a new PointLight at the given position with the
+// given intensity.
+func NewPointLight(position Tuple, intensity Color) *PointLight {
+	return &PointLight{Position: position, intensity: intensity}
+}
+
+// Intensity returns the color and brightness of the light.
+func (l *PointLight) Intensity() Color {
+	return l.intensity
+}
+
+// SamplePoints returns the light's single position.
+func (l *PointLight) SamplePoints(rng *rand.Rand) []Tuple {
+	return []Tuple{l.Position}
+}
+
+// An AreaLight treats a rectangle, defined by a corner point and two edge
+// vectors divided into usteps x vsteps cells, as an emitter. Sampling one
+// jittered point per cell and averaging the resulting shading and shadow
+// contributions produces soft shadows.
+type AreaLight struct {
+	Corner    Tuple
+	UVec      Tuple
+	VVec      Tuple
+	USteps    int
+	VSteps    int
+	intensity Color
+}
+
+// NewAreaLight creates a new AreaLight spanning corner+uvec and corner+vvec,
+// divided into usteps x vsteps sample cells.
+func NewAreaLight(corner, uvec, vvec Tuple, usteps, vsteps int, intensity Color) *AreaLight {
+	return &AreaLight{
+		Corner:    corner,
+		UVec:      uvec.Multiply(1 / float64(usteps)),
+		VVec:      vvec.Multiply(1 / float64(vsteps)),
+		USteps:    usteps,
+		VSteps:    vsteps,
+		intensity: intensity,
+	}
+}
+
+// Intensity returns the color and brightness of the light.
+func (l *AreaLight) Intensity() Color {
+	return l.intensity
+}
+
+// Samples returns the total number of cells covering the light.
+func (l *AreaLight) Samples() int {
+	return l.USteps * l.VSteps
+}
+
+// PointAt returns a jittered point within cell (u, v) of the light.
+func (l *AreaLight) PointAt(u, v int, rng *rand.Rand) Tuple {
+	point := l.Corner.Add(l.UVec.Multiply(float64(u) + rng.Float64()))
+	return point.Add(l.VVec.Multiply(float64(v) + rng.Float64()))
+}
+
+// SamplePoints returns one jittered point per cell covering the light.
+func (l *AreaLight) SamplePoints(rng *rand.Rand) []Tuple {
+	points := make([]Tuple, 0, l.Samples())
+	for v := 0; v < l.VSteps; v++ {
+		for u := 0; u < l.USteps; u++ {
+			points = append(points, l.PointAt(u, v, rng))
+		}
+	}
+
+	return points
+}
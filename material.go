@@ -0,0 +1,28 @@
+package rt
+
+// A Material describes how a surface interacts with light.
+type Material struct {
+	Pattern         Pattern
+	Color           Color
+	Ambient         float64
+	Diffuse         float64
+	Specular        float64
+	Shininess       float64
+	Reflective      float64
+	Transparency    float64
+	RefractiveIndex float64
+}
+
+// NewMaterial creates a new Material with the standard default properties.
+func NewMaterial() *Material {
+	return &Material{
+		Color:           white,
+		Ambient:         0.1,
+		Diffuse:         0.9,
+		Specular:        0.9,
+		Shininess:       200,
+		Reflective:      0,
+		Transparency:    0,
+		RefractiveIndex: 1,
+	}
+}
@@ -0,0 +1,33 @@
+package rt
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNoise3Range(t *testing.T) {
+	for x := 0.0; x < 10; x += 0.37 {
+		for y := 0.0; y < 10; y += 0.53 {
+			n := Noise3(x, y, x+y)
+			if n < -1 || n > 1 {
+				t.Fatalf("Noise3(%v, %v, %v) = %v, want in [-1, 1]", x, y, x+y, n)
+			}
+		}
+	}
+}
+
+func TestNoise3Deterministic(t *testing.T) {
+	a := Noise3(1.5, 2.5, 3.5)
+	b := Noise3(1.5, 2.5, 3.5)
+	if a != b {
+		t.Errorf("Noise3 is not deterministic: got %v then %v for the same input", a, b)
+	}
+}
+
+func TestNoise3VariesWithInput(t *testing.T) {
+	a := Noise3(0, 0, 0)
+	b := Noise3(5.2, 1.1, 9.9)
+	if math.Abs(a-b) < 1e-9 {
+		t.Errorf("Noise3 returned nearly identical values for different inputs: %v vs %v", a, b)
+	}
+}
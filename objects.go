@@ -6,16 +6,38 @@ import (
 
 // A Sphere represents a sphere.
 type Sphere struct {
-	Transform Matrix
+	Transform Transformation
+	Material  *Material
 }
 
 // NewSphere creates a new Sphere.
 func NewSphere() *Sphere {
 	return &Sphere{
 		Transform: NewTransform(),
+		Material:  NewMaterial(),
 	}
 }
 
+// GetTransform returns the sphere's transformation.
+func (s *Sphere) GetTransform() Transformation {
+	return s.Transform
+}
+
+// SetTransform sets the sphere's transformation.
+func (s *Sphere) SetTransform(transform Transformation) {
+	s.Transform = transform
+}
+
+// GetMaterial returns the sphere's material.
+func (s *Sphere) GetMaterial() *Material {
+	return s.Material
+}
+
+// SetMaterial sets the sphere's material.
+func (s *Sphere) SetMaterial(material *Material) {
+	s.Material = material
+}
+
 // Intersect returns a set of points where a ray intersects the sphere.
 func (s *Sphere) Intersect(ray *Ray) IntersectionSet {
 	r := ray.Transform(s.Transform.Inverse())
@@ -36,6 +58,12 @@ func (s *Sphere) Intersect(ray *Ray) IntersectionSet {
 	}
 }
 
+// LocalBounds returns the sphere's bounding box in its own object space: a
+// cube from (-1, -1, -1) to (1, 1, 1).
+func (s *Sphere) LocalBounds() BoundingBox {
+	return NewBoundingBox(NewPoint(-1, -1, -1), NewPoint(1, 1, 1))
+}
+
 // NormalAt returns the normal vector from the sphere for a point p.
 func (s *Sphere) NormalAt(worldPoint Tuple) Tuple {
 	objectPoint := s.Transform.Inverse().MultiplyTuple(worldPoint)
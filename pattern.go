@@ -68,16 +68,37 @@ func (p *SolidPattern) At(point Tuple) Color {
 	return p.color
 }
 
-// A BlendedPattern is blended combination of two other patterns.
+// A BlendMode determines how a BlendedPattern combines its two patterns'
+// colors at a point.
+type BlendMode int
+
+// The blend modes a BlendedPattern can use.
+const (
+	BlendAverage BlendMode = iota
+	BlendMultiply
+	BlendScreen
+	BlendAdd
+	BlendOverlay
+)
+
+// A BlendedPattern is a blended combination of two other patterns.
 type BlendedPattern struct {
 	PatternProps
 	patternA Pattern
 	patternB Pattern
+	mode     BlendMode
 }
 
-// NewBlendedPattern creates a new BlendedPatter.
+// NewBlendedPattern creates a new BlendedPattern that averages patternA and
+// patternB.
 func NewBlendedPattern(a Pattern, b Pattern) *BlendedPattern {
-	pattern := &BlendedPattern{NewPatternProps(nil, nil), a, b}
+	return NewBlendedPatternMode(a, b, BlendAverage)
+}
+
+// NewBlendedPatternMode creates a new BlendedPattern that combines
+// patternA and patternB using the given BlendMode.
+func NewBlendedPatternMode(a Pattern, b Pattern, mode BlendMode) *BlendedPattern {
+	pattern := &BlendedPattern{NewPatternProps(a, b), a, b, mode}
 	pattern.p = pattern
 	return pattern
 }
@@ -85,16 +106,35 @@ func NewBlendedPattern(a Pattern, b Pattern) *BlendedPattern {
 // AtObject returns the pattern color on the specified object at the specified point.
 func (p *BlendedPattern) AtObject(object Shape, worldPoint Tuple) Color {
 	localPoint := object.GetTransform().Inverse().ApplyTo(worldPoint)
+
 	patternPointA := p.patternA.GetTransform().Inverse().ApplyTo(localPoint)
 	colorA := p.patternA.At(patternPointA)
-	patternPointB := p.GetTransform().Inverse().ApplyTo(localPoint)
-	colorB := p.patternA.At(patternPointB)
-	return colorA.AverageBlend(colorB)
+
+	patternPointB := p.patternB.GetTransform().Inverse().ApplyTo(localPoint)
+	colorB := p.patternB.At(patternPointB)
+
+	return p.blend(colorA, colorB)
 }
 
 // At returns the pattern color at the given point.
 func (p *BlendedPattern) At(point Tuple) Color {
-	return nil
+	return p.blend(p.patternA.At(point), p.patternB.At(point))
+}
+
+// blend combines colorA and colorB according to p.mode.
+func (p *BlendedPattern) blend(colorA, colorB Color) Color {
+	switch p.mode {
+	case BlendMultiply:
+		return colorA.MultiplyColor(colorB)
+	case BlendScreen:
+		return colorA.Screen(colorB)
+	case BlendAdd:
+		return colorA.Add(colorB)
+	case BlendOverlay:
+		return colorA.Overlay(colorB)
+	default:
+		return colorA.AverageBlend(colorB)
+	}
 }
 
 // A StripePattern is a pattern of colors alternates in the X axis.
@@ -170,6 +210,37 @@ func NewCheckerPattern(a Pattern, b Pattern) *CheckerPattern {
 	return pattern
 }
 
+// A PerturbedPattern wraps another pattern and jitters the sample point
+// with 3D Perlin noise before delegating to it, giving marble/wood/cloud
+// looks on top of any existing pattern.
+type PerturbedPattern struct {
+	PatternProps
+	inner Pattern
+	scale float64
+}
+
+// NewPerturbedPattern creates a new PerturbedPattern that perturbs samples
+// of inner by up to scale in each axis.
+func NewPerturbedPattern(inner Pattern, scale float64) *PerturbedPattern {
+	pattern := &PerturbedPattern{NewPatternProps(nil, nil), inner, scale}
+	pattern.p = pattern
+	return pattern
+}
+
+// At returns the inner pattern's color at a point perturbed by noise.
+func (p *PerturbedPattern) At(point Tuple) Color {
+	return p.inner.At(p.perturb(point))
+}
+
+// perturb offsets point along each axis by a differently-seeded sample of
+// the same noise field, scaled by p.scale.
+func (p *PerturbedPattern) perturb(point Tuple) Tuple {
+	x := point.X() + Noise3(point.X(), point.Y(), point.Z())*p.scale
+	y := point.Y() + Noise3(point.X(), point.Y(), point.Z()+1)*p.scale
+	z := point.Z() + Noise3(point.X(), point.Y(), point.Z()+2)*p.scale
+	return NewPoint(x, y, z)
+}
+
 // At returns the pattern color at the given point.
 func (p *CheckerPattern) At(point Tuple) Color {
 	if (int(math.Floor(point.X()))+int(math.Floor(point.Y()))+int(math.Floor(point.Z())))%2 == 0 {
@@ -0,0 +1,548 @@
+// Package scene loads a rendering scene from a YAML description, rather
+// than requiring it to be authored in Go.
+package scene
+
+import (
+	"fmt"
+	"os"
+
+	rt "github.com/jefflinse/ray-tracer"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// A Scene is a loaded, ready-to-render World together with the Camera that
+// views it.
+type Scene struct {
+	World  *rt.World
+	Camera *rt.Camera
+}
+
+// definition is a named material or transform template declared with a
+// top-level "define" item, which later items can reference via "extend".
+// value holds whatever the YAML "value" decoded to: a map[string]interface{}
+// for a material/pattern template, or a []interface{} for a transform list.
+type definition struct {
+	value interface{}
+}
+
+// Load reads and parses the YAML scene description at path, resolving any
+// define/extend templates, and returns the resulting Scene.
+func Load(path string) (*Scene, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("scene: reading %q: %w", path, err)
+	}
+
+	var items []map[string]interface{}
+	if err := yaml.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("scene: parsing %q: %w", path, err)
+	}
+
+	l := &loader{
+		world:       rt.NewWorld(),
+		definitions: map[string]definition{},
+	}
+
+	for _, item := range items {
+		if err := l.loadItem(item); err != nil {
+			return nil, fmt.Errorf("scene: %q: %w", path, err)
+		}
+	}
+
+	if l.camera == nil {
+		return nil, fmt.Errorf("scene: %q: missing a \"camera\" item", path)
+	}
+
+	return &Scene{World: l.world, Camera: l.camera}, nil
+}
+
+// loader accumulates state (the world under construction, the camera, and
+// any named definitions) as it works through a scene file's items in
+// order.
+type loader struct {
+	world       *rt.World
+	camera      *rt.Camera
+	definitions map[string]definition
+}
+
+func (l *loader) loadItem(item map[string]interface{}) error {
+	if name, ok := item["define"].(string); ok {
+		return l.loadDefine(name, item)
+	}
+
+	if kind, ok := item["add"].(string); ok {
+		return l.loadAdd(kind, item)
+	}
+
+	return fmt.Errorf("item has neither \"define\" nor \"add\": %v", item)
+}
+
+func (l *loader) loadDefine(name string, item map[string]interface{}) error {
+	value := item["value"]
+
+	if base, ok := item["extend"].(string); ok {
+		baseDef, ok := l.definitions[base]
+		if !ok {
+			return fmt.Errorf("define %q extends unknown definition %q", name, base)
+		}
+
+		value = mergeDefinitionValues(baseDef.value, value)
+	}
+
+	l.definitions[name] = definition{value: value}
+	return nil
+}
+
+// mergeDefinitionValues merges an "extend"ed definition's value into base:
+// maps (materials, patterns) are merged key by key, with override winning
+// ties; lists (transforms) are merged by appending override's entries onto
+// base's.
+func mergeDefinitionValues(base, override interface{}) interface{} {
+	switch b := base.(type) {
+	case map[string]interface{}:
+		merged := map[string]interface{}{}
+		for k, v := range b {
+			merged[k] = v
+		}
+
+		if o, ok := override.(map[string]interface{}); ok {
+			for k, v := range o {
+				merged[k] = v
+			}
+		}
+
+		return merged
+
+	case []interface{}:
+		merged := append([]interface{}{}, b...)
+		if o, ok := override.([]interface{}); ok {
+			merged = append(merged, o...)
+		}
+
+		return merged
+
+	default:
+		return override
+	}
+}
+
+func (l *loader) loadAdd(kind string, item map[string]interface{}) error {
+	switch kind {
+	case "camera":
+		camera, err := l.buildCamera(item)
+		if err != nil {
+			return err
+		}
+
+		l.camera = camera
+
+	case "light":
+		light, err := l.buildLight(item)
+		if err != nil {
+			return err
+		}
+
+		l.world.Lights = append(l.world.Lights, light)
+
+	case "sphere":
+		shape, err := l.buildSphere(item)
+		if err != nil {
+			return err
+		}
+
+		l.world.Objects = append(l.world.Objects, shape)
+
+	default:
+		return fmt.Errorf("unsupported \"add\" kind %q", kind)
+	}
+
+	return nil
+}
+
+func (l *loader) buildCamera(item map[string]interface{}) (*rt.Camera, error) {
+	width := intField(item, "width", 100)
+	height := intField(item, "height", 100)
+	fov := floatField(item, "field-of-view", 0.785)
+
+	camera := rt.NewCamera(width, height, fov)
+	transform, err := l.buildTransform(item["transform"])
+	if err != nil {
+		return nil, err
+	}
+
+	camera.Transform = transform
+	return camera, nil
+}
+
+// buildLight builds either a PointLight, from an "at" position, or an
+// AreaLight, from a "corner" plus "uvec"/"vvec" edges and "usteps"/"vsteps"
+// cell counts — the rectangular-light form the "corner" key signals.
+func (l *loader) buildLight(item map[string]interface{}) (rt.Light, error) {
+	intensity, err := colorField(item, "intensity", rt.NewColor(1, 1, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := item["corner"]; ok {
+		corner, err := tupleField(item, "corner", rt.NewPoint(0, 0, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		uvec, err := vectorField(item, "uvec", rt.NewVector(1, 0, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		vvec, err := vectorField(item, "vvec", rt.NewVector(0, 1, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		usteps := intField(item, "usteps", 1)
+		vsteps := intField(item, "vsteps", 1)
+		return rt.NewAreaLight(corner, uvec, vvec, usteps, vsteps, intensity), nil
+	}
+
+	at, err := tupleField(item, "at", rt.NewPoint(0, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return rt.NewPointLight(at, intensity), nil
+}
+
+func (l *loader) buildSphere(item map[string]interface{}) (rt.Shape, error) {
+	sphere := rt.NewSphere()
+
+	material, err := l.buildMaterial(item["material"])
+	if err != nil {
+		return nil, err
+	}
+
+	sphere.Material = material
+
+	transform, err := l.buildTransform(item["transform"])
+	if err != nil {
+		return nil, err
+	}
+
+	sphere.Transform = transform
+	return sphere, nil
+}
+
+// buildMaterial resolves value, which may be a literal material map or the
+// name of a "define"d material, into a *rt.Material.
+func (l *loader) buildMaterial(value interface{}) (*rt.Material, error) {
+	material := rt.NewMaterial()
+	fields, err := l.resolveFields(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if c, ok := fields["color"]; ok {
+		color, err := toColor(c)
+		if err != nil {
+			return nil, err
+		}
+
+		material.Color = color
+	}
+
+	if v, ok := fields["ambient"].(float64); ok {
+		material.Ambient = v
+	}
+
+	if v, ok := fields["diffuse"].(float64); ok {
+		material.Diffuse = v
+	}
+
+	if v, ok := fields["specular"].(float64); ok {
+		material.Specular = v
+	}
+
+	if v, ok := fields["shininess"].(float64); ok {
+		material.Shininess = v
+	}
+
+	if v, ok := fields["reflective"].(float64); ok {
+		material.Reflective = v
+	}
+
+	if v, ok := fields["transparency"].(float64); ok {
+		material.Transparency = v
+	}
+
+	if v, ok := fields["refractive-index"].(float64); ok {
+		material.RefractiveIndex = v
+	}
+
+	if p, ok := fields["pattern"]; ok {
+		pattern, err := l.buildPattern(p)
+		if err != nil {
+			return nil, err
+		}
+
+		material.Pattern = pattern
+	}
+
+	return material, nil
+}
+
+// buildPattern resolves value into an rt.Pattern. value may be a literal
+// 3-element color list (treated as a SolidPattern), the name of a
+// "define"d pattern, or a map with a "type" of stripes/gradient/ring/
+// checkers/blended, an "a"/"b" sub-pattern (each resolved recursively, so
+// patterns can nest arbitrarily deep), and an optional "transform".
+func (l *loader) buildPattern(value interface{}) (rt.Pattern, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		color, err := toColor(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return rt.NewSolidPattern(color), nil
+
+	case string:
+		def, ok := l.definitions[v]
+		if !ok {
+			return nil, fmt.Errorf("undefined pattern %q", v)
+		}
+
+		return l.buildPattern(def.value)
+
+	case map[string]interface{}:
+		return l.buildPatternFromFields(v)
+
+	default:
+		return nil, fmt.Errorf("invalid pattern value: %v", value)
+	}
+}
+
+func (l *loader) buildPatternFromFields(fields map[string]interface{}) (rt.Pattern, error) {
+	kind, _ := fields["type"].(string)
+
+	var a, b rt.Pattern
+	var err error
+	if av, ok := fields["a"]; ok {
+		if a, err = l.buildPattern(av); err != nil {
+			return nil, err
+		}
+	}
+
+	if bv, ok := fields["b"]; ok {
+		if b, err = l.buildPattern(bv); err != nil {
+			return nil, err
+		}
+	}
+
+	var pattern rt.Pattern
+	switch kind {
+	case "stripes":
+		pattern = rt.NewStripePattern(a, b)
+	case "gradient":
+		pattern = rt.NewGradientPattern(a, b)
+	case "ring":
+		pattern = rt.NewRingPattern(a, b)
+	case "checkers":
+		pattern = rt.NewCheckerPattern(a, b)
+	case "blended":
+		mode, err := blendModeNamed(fields["mode"])
+		if err != nil {
+			return nil, err
+		}
+
+		pattern = rt.NewBlendedPatternMode(a, b, mode)
+	default:
+		return nil, fmt.Errorf("unsupported pattern type %q", kind)
+	}
+
+	transform, err := l.buildTransform(fields["transform"])
+	if err != nil {
+		return nil, err
+	}
+
+	pattern.SetTransform(transform)
+	return pattern, nil
+}
+
+// blendModeNamed resolves a YAML "mode" field into an rt.BlendMode,
+// defaulting to BlendAverage when mode is absent.
+func blendModeNamed(mode interface{}) (rt.BlendMode, error) {
+	name, _ := mode.(string)
+	switch name {
+	case "", "average":
+		return rt.BlendAverage, nil
+	case "multiply":
+		return rt.BlendMultiply, nil
+	case "screen":
+		return rt.BlendScreen, nil
+	case "add":
+		return rt.BlendAdd, nil
+	case "overlay":
+		return rt.BlendOverlay, nil
+	default:
+		return rt.BlendAverage, fmt.Errorf("unsupported blend mode %q", name)
+	}
+}
+
+// buildTransform folds a YAML transform list (each entry keyed by
+// translate/scale/rotate-x|y|z/shear) into a single composed
+// rt.Transformation, or the name of a "define"d transform list.
+func (l *loader) buildTransform(value interface{}) (rt.Transformation, error) {
+	transform := rt.NewTransform()
+	if value == nil {
+		return transform, nil
+	}
+
+	entries, err := l.resolveTransformEntries(value)
+	if err != nil {
+		return transform, err
+	}
+
+	for _, entry := range entries {
+		parts, ok := entry.([]interface{})
+		if !ok || len(parts) == 0 {
+			return transform, fmt.Errorf("invalid transform entry: %v", entry)
+		}
+
+		op, _ := parts[0].(string)
+		args := make([]float64, len(parts)-1)
+		for i, p := range parts[1:] {
+			args[i] = toFloat(p)
+		}
+
+		switch op {
+		case "translate":
+			transform = transform.Translate(args[0], args[1], args[2])
+		case "scale":
+			transform = transform.Scale(args[0], args[1], args[2])
+		case "rotate-x":
+			transform = transform.RotateX(args[0])
+		case "rotate-y":
+			transform = transform.RotateY(args[0])
+		case "rotate-z":
+			transform = transform.RotateZ(args[0])
+		case "shear":
+			transform = transform.Shear(args[0], args[1], args[2], args[3], args[4], args[5])
+		default:
+			return transform, fmt.Errorf("unsupported transform operation %q", op)
+		}
+	}
+
+	return transform, nil
+}
+
+func (l *loader) resolveTransformEntries(value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		def, ok := l.definitions[v]
+		if !ok {
+			return nil, fmt.Errorf("undefined transform %q", v)
+		}
+
+		entries, ok := def.value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("definition %q is not a transform list", v)
+		}
+
+		return entries, nil
+	case []interface{}:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("invalid transform value: %v", value)
+	}
+}
+
+// resolveFields resolves value, which may be an inline map or the name of
+// a "define"d material, into a plain field map.
+func (l *loader) resolveFields(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return map[string]interface{}{}, nil
+	case string:
+		def, ok := l.definitions[v]
+		if !ok {
+			return nil, fmt.Errorf("undefined material %q", v)
+		}
+
+		fields, ok := def.value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("definition %q is not a material", v)
+		}
+
+		return fields, nil
+	case map[string]interface{}:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("invalid material value: %v", value)
+	}
+}
+
+func intField(item map[string]interface{}, key string, fallback int) int {
+	if v, ok := item[key]; ok {
+		return int(toFloat(v))
+	}
+
+	return fallback
+}
+
+func floatField(item map[string]interface{}, key string, fallback float64) float64 {
+	if v, ok := item[key]; ok {
+		return toFloat(v)
+	}
+
+	return fallback
+}
+
+func tupleField(item map[string]interface{}, key string, fallback rt.Tuple) (rt.Tuple, error) {
+	return namedTupleField(item, key, fallback, rt.NewPoint)
+}
+
+func vectorField(item map[string]interface{}, key string, fallback rt.Tuple) (rt.Tuple, error) {
+	return namedTupleField(item, key, fallback, rt.NewVector)
+}
+
+func namedTupleField(item map[string]interface{}, key string, fallback rt.Tuple, new func(x, y, z float64) rt.Tuple) (rt.Tuple, error) {
+	v, ok := item[key]
+	if !ok {
+		return fallback, nil
+	}
+
+	parts, ok := v.([]interface{})
+	if !ok || len(parts) != 3 {
+		return fallback, fmt.Errorf("%q must be a 3-element list", key)
+	}
+
+	return new(toFloat(parts[0]), toFloat(parts[1]), toFloat(parts[2])), nil
+}
+
+func colorField(item map[string]interface{}, key string, fallback rt.Color) (rt.Color, error) {
+	v, ok := item[key]
+	if !ok {
+		return fallback, nil
+	}
+
+	return toColor(v)
+}
+
+func toColor(value interface{}) (rt.Color, error) {
+	parts, ok := value.([]interface{})
+	if !ok || len(parts) != 3 {
+		return rt.Color{}, fmt.Errorf("color must be a 3-element list, got %v", value)
+	}
+
+	return rt.NewColor(toFloat(parts[0]), toFloat(parts[1]), toFloat(parts[2])), nil
+}
+
+func toFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	default:
+		return 0
+	}
+}
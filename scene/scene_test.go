@@ -0,0 +1,159 @@
+package scene
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rt "github.com/jefflinse/ray-tracer"
+)
+
+func writeScene(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "scene.yml")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing scene fixture: %v", err)
+	}
+
+	return path
+}
+
+func TestLoadCameraAndPointLight(t *testing.T) {
+	path := writeScene(t, `
+- add: camera
+  width: 200
+  height: 100
+  field-of-view: 1.0
+
+- add: light
+  at: [-10, 10, -10]
+  intensity: [1, 1, 1]
+
+- add: sphere
+`)
+
+	s, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if s.Camera.HSize != 200 || s.Camera.VSize != 100 {
+		t.Errorf("camera size = %dx%d, want 200x100", s.Camera.HSize, s.Camera.VSize)
+	}
+
+	if len(s.World.Lights) != 1 {
+		t.Fatalf("len(Lights) = %d, want 1", len(s.World.Lights))
+	}
+
+	if _, ok := s.World.Lights[0].(*rt.PointLight); !ok {
+		t.Errorf("Lights[0] = %T, want *rt.PointLight", s.World.Lights[0])
+	}
+
+	if len(s.World.Objects) != 1 {
+		t.Fatalf("len(Objects) = %d, want 1", len(s.World.Objects))
+	}
+}
+
+func TestLoadAreaLight(t *testing.T) {
+	path := writeScene(t, `
+- add: camera
+  width: 10
+  height: 10
+  field-of-view: 1.0
+
+- add: light
+  corner: [-1, 2, -1]
+  uvec: [2, 0, 0]
+  vvec: [0, 0, 2]
+  usteps: 4
+  vsteps: 4
+  intensity: [1, 1, 1]
+`)
+
+	s, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(s.World.Lights) != 1 {
+		t.Fatalf("len(Lights) = %d, want 1", len(s.World.Lights))
+	}
+
+	area, ok := s.World.Lights[0].(*rt.AreaLight)
+	if !ok {
+		t.Fatalf("Lights[0] = %T, want *rt.AreaLight", s.World.Lights[0])
+	}
+
+	if area.Samples() != 16 {
+		t.Errorf("area.Samples() = %d, want 16", area.Samples())
+	}
+}
+
+func TestLoadDefineExtendAndPattern(t *testing.T) {
+	path := writeScene(t, `
+- define: base-material
+  value:
+    ambient: 0.2
+    diffuse: 0.7
+
+- define: wall-material
+  extend: base-material
+  value:
+    specular: 0
+
+- define: standard-transform
+  value:
+    - [translate, 1, -1, 1]
+
+- define: large-transform
+  extend: standard-transform
+  value:
+    - [scale, 2, 2, 2]
+
+- add: camera
+  width: 10
+  height: 10
+  field-of-view: 1.0
+
+- add: sphere
+  material:
+    color: [1, 0, 0]
+    diffuse: 0.7
+    pattern:
+      type: checkers
+      a: [0, 0, 0]
+      b: [1, 1, 1]
+      transform:
+        - [scale, 0.5, 0.5, 0.5]
+  transform: large-transform
+`)
+
+	s, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	sphere, ok := s.World.Objects[0].(*rt.Sphere)
+	if !ok {
+		t.Fatalf("Objects[0] = %T, want *rt.Sphere", s.World.Objects[0])
+	}
+
+	if sphere.Material.Diffuse != 0.7 {
+		t.Errorf("sphere.Material.Diffuse = %v, want 0.7", sphere.Material.Diffuse)
+	}
+
+	if _, ok := sphere.Material.Pattern.(*rt.CheckerPattern); !ok {
+		t.Errorf("sphere.Material.Pattern = %T, want *rt.CheckerPattern", sphere.Material.Pattern)
+	}
+}
+
+func TestLoadMissingCameraIsAnError(t *testing.T) {
+	path := writeScene(t, `
+- add: sphere
+`)
+
+	if _, err := Load(path); err == nil {
+		t.Error("Load() error = nil, want an error for a scene with no camera")
+	}
+}
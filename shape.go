@@ -0,0 +1,17 @@
+package rt
+
+// A Shape is any object that can be placed in a World, transformed, and
+// intersected by a Ray.
+type Shape interface {
+	GetTransform() Transformation
+	SetTransform(transform Transformation)
+	GetMaterial() *Material
+	SetMaterial(material *Material)
+	Intersect(ray *Ray) IntersectionSet
+	NormalAt(point Tuple) Tuple
+
+	// LocalBounds returns the shape's bounding box in its own object
+	// space, before its transform is applied. Use ParentSpaceBounds to
+	// get the box in the space of the shape's parent.
+	LocalBounds() BoundingBox
+}
@@ -0,0 +1,395 @@
+package rt
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/png"
+	"math"
+	"os"
+)
+
+// A UVMapper converts a point on the surface of a shape into 2D (u, v)
+// texture coordinates, both in [0, 1).
+type UVMapper func(point Tuple) (u, v float64)
+
+// SphericalMap maps a point on a sphere to (u, v) using longitude/latitude.
+func SphericalMap(point Tuple) (u, v float64) {
+	theta := math.Atan2(point.X(), point.Z())
+	vec := NewVector(point.X(), point.Y(), point.Z())
+	radius := vec.Magnitude()
+	phi := math.Acos(point.Y() / radius)
+
+	rawU := theta / (2 * math.Pi)
+	u = 1 - (rawU + 0.5)
+	v = 1 - phi/math.Pi
+	return u, v
+}
+
+// PlanarMap maps a point on a flat plane to (u, v), wrapping every unit.
+func PlanarMap(point Tuple) (u, v float64) {
+	u = math.Mod(point.X(), 1)
+	if u < 0 {
+		u++
+	}
+
+	v = math.Mod(point.Z(), 1)
+	if v < 0 {
+		v++
+	}
+
+	return u, v
+}
+
+// CylindricalMap maps a point on a cylinder of radius 1 to (u, v).
+func CylindricalMap(point Tuple) (u, v float64) {
+	theta := math.Atan2(point.X(), point.Z())
+	rawU := theta / (2 * math.Pi)
+	u = 1 - (rawU + 0.5)
+	v = math.Mod(point.Y(), 1)
+	if v < 0 {
+		v++
+	}
+
+	return u, v
+}
+
+// CubeFace identifies one of the six faces of a cube used by CubeMap.
+type CubeFace int
+
+// The six faces a CubeMap can route a point to.
+const (
+	CubeFront CubeFace = iota
+	CubeBack
+	CubeLeft
+	CubeRight
+	CubeUp
+	CubeDown
+)
+
+// FaceFromPoint returns the face of a unit cube that the given point lies
+// on, choosing whichever axis has the greatest magnitude.
+func FaceFromPoint(point Tuple) CubeFace {
+	coord := math.Max(math.Abs(point.X()), math.Max(math.Abs(point.Y()), math.Abs(point.Z())))
+
+	switch {
+	case coord == point.X():
+		return CubeRight
+	case coord == -point.X():
+		return CubeLeft
+	case coord == point.Y():
+		return CubeUp
+	case coord == -point.Y():
+		return CubeDown
+	case coord == point.Z():
+		return CubeFront
+	default:
+		return CubeBack
+	}
+}
+
+// CubeMap maps a point on the surface of a unit cube to (u, v), along with
+// the face it landed on so a CubeMapPattern can pick the right texture.
+func CubeMap(point Tuple) (face CubeFace, u, v float64) {
+	face = FaceFromPoint(point)
+
+	switch face {
+	case CubeFront:
+		u = math.Mod(point.X()+1, 2) / 2
+		v = math.Mod(point.Y()+1, 2) / 2
+	case CubeBack:
+		u = math.Mod(1-point.X(), 2) / 2
+		v = math.Mod(point.Y()+1, 2) / 2
+	case CubeLeft:
+		u = math.Mod(point.Z()+1, 2) / 2
+		v = math.Mod(point.Y()+1, 2) / 2
+	case CubeRight:
+		u = math.Mod(1-point.Z(), 2) / 2
+		v = math.Mod(point.Y()+1, 2) / 2
+	case CubeUp:
+		u = math.Mod(point.X()+1, 2) / 2
+		v = math.Mod(1-point.Z(), 2) / 2
+	case CubeDown:
+		u = math.Mod(point.X()+1, 2) / 2
+		v = math.Mod(point.Z()+1, 2) / 2
+	}
+
+	return face, u, v
+}
+
+// A UVPattern produces a color from 2D (u, v) texture coordinates.
+type UVPattern interface {
+	UVColorAt(u, v float64) Color
+}
+
+// UVCheckers is a checkerboard UVPattern with the given number of squares
+// across its width and height.
+type UVCheckers struct {
+	Width  int
+	Height int
+	A      Color
+	B      Color
+}
+
+// NewUVCheckers creates a new UVCheckers pattern.
+func NewUVCheckers(width, height int, a, b Color) *UVCheckers {
+	return &UVCheckers{Width: width, Height: height, A: a, B: b}
+}
+
+// UVColorAt returns the checker color at the given (u, v) coordinates.
+func (p *UVCheckers) UVColorAt(u, v float64) Color {
+	u2 := math.Floor(u * float64(p.Width))
+	v2 := math.Floor(v * float64(p.Height))
+	if int(u2+v2)%2 == 0 {
+		return p.A
+	}
+
+	return p.B
+}
+
+// UVAlignCheck marks the center, and three of the four corners, of a UV
+// square with distinct colors. It's useful for verifying that a UVMapper
+// and a texture are aligned the way they're expected to be.
+type UVAlignCheck struct {
+	Main        Color
+	UpperLeft   Color
+	UpperRight  Color
+	BottomLeft  Color
+	BottomRight Color
+}
+
+// NewUVAlignCheck creates a new UVAlignCheck pattern.
+func NewUVAlignCheck(main, upperLeft, upperRight, bottomLeft, bottomRight Color) *UVAlignCheck {
+	return &UVAlignCheck{
+		Main:        main,
+		UpperLeft:   upperLeft,
+		UpperRight:  upperRight,
+		BottomLeft:  bottomLeft,
+		BottomRight: bottomRight,
+	}
+}
+
+// UVColorAt returns the marker color at the given (u, v) coordinates.
+func (p *UVAlignCheck) UVColorAt(u, v float64) Color {
+	if v > 0.8 {
+		if u < 0.2 {
+			return p.UpperLeft
+		}
+		if u > 0.8 {
+			return p.UpperRight
+		}
+	} else if v < 0.2 {
+		if u < 0.2 {
+			return p.BottomLeft
+		}
+		if u > 0.8 {
+			return p.BottomRight
+		}
+	}
+
+	return p.Main
+}
+
+// An Image is a rectangular grid of colors sampled by TextureMapPattern.
+type Image struct {
+	Width  int
+	Height int
+	Pixels [][]Color
+}
+
+// At returns the color of the pixel at (x, y), clamping out-of-range
+// coordinates to the edge of the image.
+func (img *Image) At(x, y int) Color {
+	if x < 0 {
+		x = 0
+	} else if x >= img.Width {
+		x = img.Width - 1
+	}
+
+	if y < 0 {
+		y = 0
+	} else if y >= img.Height {
+		y = img.Height - 1
+	}
+
+	return img.Pixels[y][x]
+}
+
+// Bilinear returns the color at fractional pixel coordinates (x, y),
+// blending the four nearest pixels.
+func (img *Image) Bilinear(x, y float64) Color {
+	x0 := int(math.Floor(x))
+	y0 := int(math.Floor(y))
+	x1 := x0 + 1
+	y1 := y0 + 1
+
+	dx := x - float64(x0)
+	dy := y - float64(y0)
+
+	top := img.At(x0, y0).Multiply(1 - dx).Add(img.At(x1, y0).Multiply(dx))
+	bottom := img.At(x0, y1).Multiply(1 - dx).Add(img.At(x1, y1).Multiply(dx))
+	return top.Multiply(1 - dy).Add(bottom.Multiply(dy))
+}
+
+// LoadPPM reads a PPM (P3, ASCII) image from path.
+func LoadPPM(path string) (*Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("rt: opening ppm %q: %w", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanWords)
+
+	next := func() string {
+		scanner.Scan()
+		return scanner.Text()
+	}
+
+	if magic := next(); magic != "P3" {
+		return nil, fmt.Errorf("rt: unsupported ppm magic number %q", magic)
+	}
+
+	width := atoiOrZero(next())
+	height := atoiOrZero(next())
+	maxValue := atoiOrZero(next())
+
+	img := &Image{Width: width, Height: height, Pixels: make([][]Color, height)}
+	for y := 0; y < height; y++ {
+		img.Pixels[y] = make([]Color, width)
+		for x := 0; x < width; x++ {
+			r := atoiOrZero(next())
+			g := atoiOrZero(next())
+			b := atoiOrZero(next())
+			img.Pixels[y][x] = NewColor(
+				float64(r)/float64(maxValue),
+				float64(g)/float64(maxValue),
+				float64(b)/float64(maxValue),
+			)
+		}
+	}
+
+	return img, nil
+}
+
+// LoadPNG reads a PNG image from path.
+func LoadPNG(path string) (*Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("rt: opening png %q: %w", path, err)
+	}
+	defer file.Close()
+
+	decoded, err := png.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("rt: decoding png %q: %w", path, err)
+	}
+
+	bounds := decoded.Bounds()
+	img := &Image{Width: bounds.Dx(), Height: bounds.Dy(), Pixels: make([][]Color, bounds.Dy())}
+	for y := 0; y < img.Height; y++ {
+		img.Pixels[y] = make([]Color, img.Width)
+		for x := 0; x < img.Width; x++ {
+			img.Pixels[y][x] = colorFromRGBA(decoded.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return img, nil
+}
+
+func colorFromRGBA(c image.Color) Color {
+	r, g, b, _ := c.RGBA()
+	return NewColor(float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff)
+}
+
+func atoiOrZero(s string) int {
+	n := 0
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0
+		}
+		n = n*10 + int(c-'0')
+	}
+
+	return n
+}
+
+// A TextureMapPattern samples an Image using a UVMapper, giving shapes a
+// real image texture instead of a procedural pattern.
+type TextureMapPattern struct {
+	PatternProps
+	Image  *Image
+	Mapper UVMapper
+}
+
+// NewTextureMapPattern creates a new TextureMapPattern that samples image
+// using the given UVMapper.
+func NewTextureMapPattern(image *Image, mapper UVMapper) *TextureMapPattern {
+	pattern := &TextureMapPattern{NewPatternProps(nil, nil), image, mapper}
+	pattern.p = pattern
+	return pattern
+}
+
+// At returns the bilinearly-sampled image color at the given point.
+func (p *TextureMapPattern) At(point Tuple) Color {
+	u, v := p.Mapper(point)
+	x := u * float64(p.Image.Width-1)
+	y := (1 - v) * float64(p.Image.Height-1)
+	return p.Image.Bilinear(x, y)
+}
+
+// A UVMapPattern samples a UVPattern using a UVMapper, the procedural
+// counterpart to TextureMapPattern.
+type UVMapPattern struct {
+	PatternProps
+	Pattern UVPattern
+	Mapper  UVMapper
+}
+
+// NewUVMapPattern creates a new UVMapPattern that samples pattern using the
+// given UVMapper.
+func NewUVMapPattern(pattern UVPattern, mapper UVMapper) *UVMapPattern {
+	p := &UVMapPattern{NewPatternProps(nil, nil), pattern, mapper}
+	p.p = p
+	return p
+}
+
+// At returns the pattern color at the (u, v) coordinates for the given
+// point.
+func (p *UVMapPattern) At(point Tuple) Color {
+	u, v := p.Mapper(point)
+	return p.Pattern.UVColorAt(u, v)
+}
+
+// A CubeMapPattern samples one of six UVPatterns, one per cube face, using
+// CubeMap to decide which face and (u, v) coordinates apply.
+type CubeMapPattern struct {
+	PatternProps
+	Faces map[CubeFace]UVPattern
+}
+
+// NewCubeMapPattern creates a new CubeMapPattern from a UVPattern for each
+// of the six faces.
+func NewCubeMapPattern(front, back, left, right, up, down UVPattern) *CubeMapPattern {
+	p := &CubeMapPattern{
+		PatternProps: NewPatternProps(nil, nil),
+		Faces: map[CubeFace]UVPattern{
+			CubeFront: front,
+			CubeBack:  back,
+			CubeLeft:  left,
+			CubeRight: right,
+			CubeUp:    up,
+			CubeDown:  down,
+		},
+	}
+	p.p = p
+	return p
+}
+
+// At returns the pattern color for the cube face and (u, v) coordinates the
+// given point maps to.
+func (p *CubeMapPattern) At(point Tuple) Color {
+	face, u, v := CubeMap(point)
+	return p.Faces[face].UVColorAt(u, v)
+}
@@ -0,0 +1,167 @@
+package rt
+
+import (
+	"math"
+	"math/rand"
+)
+
+// A World holds the collection of objects and lights that make up a scene
+// to be rendered.
+type World struct {
+	Objects []Shape
+	Lights  []Light
+}
+
+// NewWorld creates a new, empty World.
+func NewWorld() *World {
+	return &World{}
+}
+
+// IntersectAll returns every intersection of the ray with every object in
+// the world, sorted by t.
+func (w *World) IntersectAll(ray *Ray) IntersectionSet {
+	var all IntersectionSet
+	for _, object := range w.Objects {
+		all = append(all, object.Intersect(ray)...)
+	}
+
+	all.Sort()
+	return all
+}
+
+// IsShadowed reports whether the given world-space point is in shadow with
+// respect to a light at lightPosition.
+func (w *World) IsShadowed(point Tuple, lightPosition Tuple) bool {
+	vector := lightPosition.Subtract(point)
+	distance := vector.Magnitude()
+	direction := vector.Normalize()
+
+	ray := NewRay(point, direction)
+	hit := w.IntersectAll(ray).Hit()
+	return hit != nil && hit.T < distance
+}
+
+// Lighting computes the shaded color at a point using the Phong reflection
+// model. It averages the contribution of every sample point returned by the
+// light, which produces soft shadows for an AreaLight and the usual hard
+// shadows for a PointLight. rng drives the light's jitter so results are
+// deterministic for a given seed.
+func Lighting(material *Material, object Shape, light Light, point, eyev, normalv Tuple, rng *rand.Rand, w *World) Color {
+	var surfaceColor Color
+	if material.Pattern != nil {
+		surfaceColor = material.Pattern.AtObject(object, point)
+	} else {
+		surfaceColor = material.Color
+	}
+
+	ambient := surfaceColor.Multiply(material.Ambient)
+
+	samples := light.SamplePoints(rng)
+	sum := black
+	for _, samplePoint := range samples {
+		if w.IsShadowed(point, samplePoint) {
+			continue
+		}
+
+		lightv := samplePoint.Subtract(point).Normalize()
+		diffuse := black
+		specular := black
+
+		lightDotNormal := lightv.Dot(normalv)
+		if lightDotNormal >= 0 {
+			diffuse = surfaceColor.Multiply(material.Diffuse * lightDotNormal)
+
+			reflectv := lightv.Negate().Reflect(normalv)
+			reflectDotEye := reflectv.Dot(eyev)
+			if reflectDotEye > 0 {
+				factor := math.Pow(reflectDotEye, material.Shininess)
+				specular = light.Intensity().Multiply(material.Specular * factor)
+			}
+		}
+
+		sum = sum.Add(diffuse).Add(specular)
+	}
+
+	average := sum.Multiply(1 / float64(len(samples)))
+	return ambient.Add(average)
+}
+
+// maxReflectionDepth bounds the reflection/refraction recursion in ColorAt
+// so a hall of mirrors can't recurse forever.
+const maxReflectionDepth = 5
+
+// ColorAt returns the color the ray sees when fired into the world,
+// combining the surface color with reflected and refracted contributions
+// up to maxReflectionDepth bounces.
+func (w *World) ColorAt(ray *Ray, rng *rand.Rand) Color {
+	return w.colorAt(ray, rng, maxReflectionDepth)
+}
+
+func (w *World) colorAt(ray *Ray, rng *rand.Rand, remaining int) Color {
+	intersections := w.IntersectAll(ray)
+	hit := intersections.Hit()
+	if hit == nil {
+		return black
+	}
+
+	comps := PrepareComputations(hit, ray, intersections)
+	return w.shadeHit(comps, rng, remaining)
+}
+
+// shadeHit combines the Phong-shaded surface color at comps with its
+// reflected and refracted contributions, using Schlick reflectance to
+// blend the two when the surface is both reflective and transparent.
+func (w *World) shadeHit(comps Computations, rng *rand.Rand, remaining int) Color {
+	material := comps.Object.GetMaterial()
+
+	surface := black
+	for _, light := range w.Lights {
+		surface = surface.Add(Lighting(material, comps.Object, light, comps.OverPoint, comps.Eyev, comps.Normalv, rng, w))
+	}
+
+	reflected := w.reflectedColor(comps, rng, remaining)
+	refracted := w.refractedColor(comps, rng, remaining)
+
+	if material.Reflective > 0 && material.Transparency > 0 {
+		reflectance := Schlick(comps)
+		return surface.Add(reflected.Multiply(reflectance)).Add(refracted.Multiply(1 - reflectance))
+	}
+
+	return surface.Add(reflected).Add(refracted)
+}
+
+// reflectedColor returns the color contributed by reflecting ray off the
+// surface at comps, or black if the surface isn't reflective or the
+// recursion limit has been reached.
+func (w *World) reflectedColor(comps Computations, rng *rand.Rand, remaining int) Color {
+	material := comps.Object.GetMaterial()
+	if remaining <= 0 || material.Reflective == 0 {
+		return black
+	}
+
+	reflectRay := NewRay(comps.OverPoint, comps.Reflectv)
+	return w.colorAt(reflectRay, rng, remaining-1).Multiply(material.Reflective)
+}
+
+// refractedColor returns the color contributed by refracting a ray through
+// the surface at comps, or black if the surface isn't transparent, the
+// recursion limit has been reached, or the angle causes total internal
+// reflection.
+func (w *World) refractedColor(comps Computations, rng *rand.Rand, remaining int) Color {
+	material := comps.Object.GetMaterial()
+	if remaining <= 0 || material.Transparency == 0 {
+		return black
+	}
+
+	nRatio := comps.N1 / comps.N2
+	cosI := comps.Eyev.Dot(comps.Normalv)
+	sin2t := nRatio * nRatio * (1 - cosI*cosI)
+	if sin2t > 1 {
+		return black
+	}
+
+	cosT := math.Sqrt(1 - sin2t)
+	direction := comps.Normalv.Multiply(nRatio*cosI - cosT).Subtract(comps.Eyev.Multiply(nRatio))
+	refractRay := NewRay(comps.UnderPoint, direction)
+	return w.colorAt(refractRay, rng, remaining-1).Multiply(material.Transparency)
+}